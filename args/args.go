@@ -2,6 +2,7 @@ package args
 
 import (
 	"github.com/namsral/flag"
+	"github.com/newrelic/infra-integrations-sdk/log"
 )
 
 // Default integration arguments. Loaded via cli or environment variables.
@@ -12,6 +13,15 @@ var (
 	Metrics   bool
 	Inventory bool
 	Events    bool
+	// LogLevel names the minimum log level to emit (trace, debug, info, warn
+	// or error). Empty means "not set", in which case Verbose decides.
+	LogLevel string
+	// ConfigPath holds the parsed -config_path/CONFIG_PATH value: an optional
+	// YAML/JSON file with integration configuration. Builder.Build loads that
+	// file (found via ResolveConfigPath, since this variable is only set once
+	// flags are parsed) when Builder.ConfigFile wasn't called. Empty means no
+	// config file is used.
+	ConfigPath string
 )
 
 // LoadDefaultArgs loads default arguments from cli or environment variables.
@@ -22,8 +32,24 @@ func LoadDefaultArgs() {
 	flag.BoolVar(&Metrics, "metrics", false, "Publish metrics data")
 	flag.BoolVar(&Inventory, "inventory", false, "Publish inventory data")
 	flag.BoolVar(&Events, "events", false, "Publish events data")
+	flag.StringVar(&LogLevel, "log_level", "", "Minimum log level to emit: trace, debug, info, warn or error")
+	flag.StringVar(&ConfigPath, "config_path", "", "Path to a YAML or JSON file with integration configuration")
 
 	if !Metrics && !Inventory && !Events {
 		All = true
 	}
 }
+
+// ResolveLogLevel returns the log.Level requested by the parsed arguments.
+// An explicit -log_level/LOG_LEVEL flag takes precedence; otherwise Verbose
+// maps to log.LevelDebug for backwards compatibility, defaulting to
+// log.LevelInfo when neither is set.
+func ResolveLogLevel() (log.Level, error) {
+	if LogLevel != "" {
+		return log.ParseLevel(LogLevel)
+	}
+	if Verbose {
+		return log.LevelDebug, nil
+	}
+	return log.LevelInfo, nil
+}
@@ -0,0 +1,86 @@
+package args
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// configPathEnvVar is the environment variable namsral/flag maps to the
+// -config_path flag.
+const configPathEnvVar = "CONFIG_PATH"
+
+// LoadConfigFile populates dst (the same pointer to a struct passed to
+// Builder.ParsedArguments) from the YAML or JSON file at path, chosen by
+// its extension (.yml/.yaml or .json). It is a no-op if path is empty.
+//
+// LoadConfigFile is meant to run before flags are parsed, so that values
+// read from the file become the flags' defaults: an operator's CLI flag or
+// environment variable still wins over the file, giving the precedence
+// file < env < CLI.
+//
+// When strict is true, a field in the file that doesn't match any field of
+// dst causes an error instead of being silently ignored.
+func LoadConfigFile(path string, dst interface{}, strict bool) error {
+	if path == "" {
+		return nil
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("can't read config file %s: %s", path, err.Error())
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yml", ".yaml":
+		if strict {
+			return yaml.UnmarshalStrict(raw, dst)
+		}
+		return yaml.Unmarshal(raw, dst)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		if strict {
+			dec.DisallowUnknownFields()
+		}
+		return dec.Decode(dst)
+	default:
+		return fmt.Errorf("unsupported config file extension %q, expected .yml, .yaml or .json", ext)
+	}
+}
+
+// ResolveConfigPath returns the config file path requested via the
+// -config_path CLI flag or CONFIG_PATH environment variable. It is meant to
+// be called by Builder.Build before args.SetupArgs, i.e. before the main
+// flag.Parse has run and populated ConfigPath, so it does its own minimal
+// scan of os.Args rather than relying on that variable.
+func ResolveConfigPath() string {
+	if path := configPathFromArgv(os.Args[1:]); path != "" {
+		return path
+	}
+	return os.Getenv(configPathEnvVar)
+}
+
+// configPathFromArgv scans argv for an explicit -config_path (or
+// --config_path) flag, in any of the forms the flag package accepts
+// ("-config_path x", "-config_path=x").
+func configPathFromArgv(argv []string) string {
+	for i, arg := range argv {
+		switch {
+		case arg == "-config_path" || arg == "--config_path":
+			if i+1 < len(argv) {
+				return argv[i+1]
+			}
+		case strings.HasPrefix(arg, "-config_path="):
+			return strings.TrimPrefix(arg, "-config_path=")
+		case strings.HasPrefix(arg, "--config_path="):
+			return strings.TrimPrefix(arg, "--config_path=")
+		}
+	}
+	return ""
+}
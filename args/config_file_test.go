@@ -0,0 +1,84 @@
+package args_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newrelic/infra-integrations-sdk/args"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	Name string `json:"name" yaml:"name"`
+	Port int    `json:"port" yaml:"port"`
+}
+
+func writeTempFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := ioutil.WriteFile(path, []byte(content), 0644)
+	assert.NoError(t, err)
+	return path
+}
+
+func TestLoadConfigFile_EmptyPathIsNoOp(t *testing.T) {
+	cfg := testConfig{}
+	err := args.LoadConfigFile("", &cfg, false)
+	assert.NoError(t, err)
+	assert.Equal(t, testConfig{}, cfg)
+}
+
+func TestLoadConfigFile_YAML(t *testing.T) {
+	path := writeTempFile(t, "config.yaml", "name: my-integration\nport: 8080\n")
+
+	cfg := testConfig{}
+	err := args.LoadConfigFile(path, &cfg, false)
+	assert.NoError(t, err)
+	assert.Equal(t, testConfig{Name: "my-integration", Port: 8080}, cfg)
+}
+
+func TestLoadConfigFile_YAMLStrictRejectsUnknownField(t *testing.T) {
+	path := writeTempFile(t, "config.yaml", "name: my-integration\nbogus: true\n")
+
+	cfg := testConfig{}
+	assert.NoError(t, args.LoadConfigFile(path, &cfg, false))
+
+	cfg = testConfig{}
+	err := args.LoadConfigFile(path, &cfg, true)
+	assert.Error(t, err)
+}
+
+func TestLoadConfigFile_JSON(t *testing.T) {
+	path := writeTempFile(t, "config.json", `{"name":"my-integration","port":8080}`)
+
+	cfg := testConfig{}
+	err := args.LoadConfigFile(path, &cfg, false)
+	assert.NoError(t, err)
+	assert.Equal(t, testConfig{Name: "my-integration", Port: 8080}, cfg)
+}
+
+func TestLoadConfigFile_JSONStrictRejectsUnknownField(t *testing.T) {
+	path := writeTempFile(t, "config.json", `{"name":"my-integration","bogus":true}`)
+
+	cfg := testConfig{}
+	assert.NoError(t, args.LoadConfigFile(path, &cfg, false))
+
+	cfg = testConfig{}
+	err := args.LoadConfigFile(path, &cfg, true)
+	assert.Error(t, err)
+}
+
+func TestLoadConfigFile_UnsupportedExtension(t *testing.T) {
+	path := writeTempFile(t, "config.toml", "name = \"my-integration\"")
+
+	cfg := testConfig{}
+	err := args.LoadConfigFile(path, &cfg, false)
+	assert.Error(t, err)
+}
+
+func TestLoadConfigFile_MissingFile(t *testing.T) {
+	cfg := testConfig{}
+	err := args.LoadConfigFile(filepath.Join(os.TempDir(), "does-not-exist.yaml"), &cfg, false)
+	assert.Error(t, err)
+}
@@ -17,9 +17,13 @@ const protocolVersion = "2"
 
 // Builder OOP builder-pattern to create a new Integration instance.
 type Builder struct {
-	integration *Integration
-	arguments   interface{}
-	logger      log.Logger
+	integration      *Integration
+	arguments        interface{}
+	logger           log.Logger
+	lruFilePath      string
+	lruMaxEntries    int
+	configFilePath   string
+	configFileStrict bool
 }
 
 type disabledLocker struct{}
@@ -70,12 +74,46 @@ func (b *Builder) InMemoryStore() *Builder {
 	return b
 }
 
+// LRUInMemoryStore sets the persistence store to an ephemeral, in-memory,
+// LRU-bounded store holding at most maxEntries keys.
+func (b *Builder) LRUInMemoryStore(maxEntries int) *Builder {
+	b.integration.storer = persist.NewLRUInMemoryStore(maxEntries)
+	return b
+}
+
+// LRUFileStore sets the persistence store to a file-backed store at path
+// that evicts the least-recently-used entry once it holds maxEntries keys.
+// The store is created during Build(), once the logger to use has been
+// resolved.
+func (b *Builder) LRUFileStore(path string, maxEntries int) *Builder {
+	b.lruFilePath = path
+	b.lruMaxEntries = maxEntries
+	return b
+}
+
 // Logger replaces the default logger (stderr)
 func (b *Builder) Logger(l log.Logger) *Builder {
 	b.logger = l
 	return b
 }
 
+// ConfigFile loads integration configuration from the YAML or JSON file at
+// path into the struct passed to ParsedArguments, before CLI flags and
+// environment variables are applied: file < env < CLI. The format is
+// chosen from the file extension (.yml/.yaml or .json).
+func (b *Builder) ConfigFile(path string) *Builder {
+	b.configFilePath = path
+	return b
+}
+
+// StrictConfigFile opts into strict parsing of the file set via ConfigFile:
+// a field in the file that doesn't match any field of the arguments struct
+// becomes a Build() error instead of being silently ignored.
+func (b *Builder) StrictConfigFile() *Builder {
+	b.configFileStrict = true
+	return b
+}
+
 // Build builds a proper integration.
 func (b *Builder) Build() (*Integration, error) {
 	// Checking errors
@@ -93,19 +131,38 @@ func (b *Builder) Build() (*Integration, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	configFilePath := b.configFilePath
+	if configFilePath == "" {
+		configFilePath = args.ResolveConfigPath()
+	}
+	if err = args.LoadConfigFile(configFilePath, b.arguments, b.configFileStrict); err != nil {
+		return nil, fmt.Errorf("can't load config file: %s", err.Error())
+	}
+
 	err = args.SetupArgs(b.arguments)
 	if err != nil {
 		return nil, err
 	}
 	defaultArgs := args.GetDefaultArgs(b.arguments)
 
-	if b.integration.storer == nil {
-		l := b.logger
-		if b.logger == nil {
-			l = log.NewStdErr(false)
+	if b.logger == nil {
+		level, err := args.ResolveLogLevel()
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level: %s", err.Error())
 		}
+		b.logger = log.NewStdErrWithLevel(level)
+	}
+
+	if b.integration.storer == nil && b.lruFilePath != "" {
+		b.integration.storer, err = persist.NewLRUFileStore(b.lruFilePath, b.lruMaxEntries, b.logger)
+		if err != nil {
+			return nil, fmt.Errorf("can't create LRU store: %s", err.Error())
+		}
+	}
 
-		b.integration.storer, err = persist.NewFileStore(persist.DefaultPath(b.integration.Name), l)
+	if b.integration.storer == nil {
+		b.integration.storer, err = persist.NewFileStore(persist.DefaultPath(b.integration.Name), b.logger)
 		if err != nil {
 			return nil, fmt.Errorf("can't create store: %s", err.Error())
 		}
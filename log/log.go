@@ -0,0 +1,126 @@
+// Package log provides the leveled logger used across the SDK and by
+// integrations built on top of it.
+package log
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+// Level identifies the verbosity of a Logger. Messages logged below the
+// configured Level are discarded.
+type Level int
+
+// Supported log levels, ordered from most to least verbose.
+const (
+	LevelTrace Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelTrace: "TRACE",
+	LevelDebug: "DEBUG",
+	LevelInfo:  "INFO",
+	LevelWarn:  "WARN",
+	LevelError: "ERROR",
+}
+
+// String returns the canonical name of the level, e.g. "DEBUG".
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// ParseLevel turns a level name such as "warn" or "ERROR" into a Level. The
+// match is case-insensitive. It returns an error if the name is not one of
+// the supported levels.
+func ParseLevel(name string) (Level, error) {
+	for lvl, lvlName := range levelNames {
+		if strings.EqualFold(lvlName, name) {
+			return lvl, nil
+		}
+	}
+	return LevelInfo, fmt.Errorf("unknown log level: %q", name)
+}
+
+// Logger is the interface used across the SDK to emit leveled log messages.
+type Logger interface {
+	// Tracef logs a message at TRACE level.
+	Tracef(format string, args ...interface{})
+	// Debugf logs a message at DEBUG level.
+	Debugf(format string, args ...interface{})
+	// Infof logs a message at INFO level.
+	Infof(format string, args ...interface{})
+	// Warnf logs a message at WARN level.
+	Warnf(format string, args ...interface{})
+	// Errorf logs a message at ERROR level.
+	Errorf(format string, args ...interface{})
+	// SetLevel changes the minimum level that will be emitted.
+	SetLevel(level Level)
+}
+
+// stdErr is the default Logger implementation, writing leveled, timestamped
+// lines to an io.Writer (os.Stderr in production).
+type stdErr struct {
+	level  Level
+	logger *log.Logger
+}
+
+// NewStdErr returns the default stderr-backed Logger. It is kept for
+// backwards compatibility: verbose=true maps to LevelDebug, verbose=false
+// maps to LevelInfo. New code should prefer NewStdErrWithLevel.
+func NewStdErr(verbose bool) Logger {
+	level := LevelInfo
+	if verbose {
+		level = LevelDebug
+	}
+	return NewStdErrWithLevel(level)
+}
+
+// NewStdErrWithLevel returns a stderr-backed Logger that only emits messages
+// at or above the given level.
+func NewStdErrWithLevel(level Level) Logger {
+	return newStdErr(os.Stderr, level)
+}
+
+func newStdErr(w io.Writer, level Level) *stdErr {
+	return &stdErr{
+		level:  level,
+		logger: log.New(w, "", log.Ldate|log.Ltime),
+	}
+}
+
+// SetLevel implements Logger.
+func (s *stdErr) SetLevel(level Level) {
+	s.level = level
+}
+
+// Tracef implements Logger.
+func (s *stdErr) Tracef(format string, args ...interface{}) { s.printf(LevelTrace, format, args...) }
+
+// Debugf implements Logger.
+func (s *stdErr) Debugf(format string, args ...interface{}) { s.printf(LevelDebug, format, args...) }
+
+// Infof implements Logger.
+func (s *stdErr) Infof(format string, args ...interface{}) { s.printf(LevelInfo, format, args...) }
+
+// Warnf implements Logger.
+func (s *stdErr) Warnf(format string, args ...interface{}) { s.printf(LevelWarn, format, args...) }
+
+// Errorf implements Logger.
+func (s *stdErr) Errorf(format string, args ...interface{}) { s.printf(LevelError, format, args...) }
+
+func (s *stdErr) printf(level Level, format string, args ...interface{}) {
+	if level < s.level {
+		return
+	}
+	s.logger.Printf("[%s] %s", level, fmt.Sprintf(format, args...))
+}
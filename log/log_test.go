@@ -0,0 +1,52 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	lvl, err := ParseLevel("warn")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelWarn, lvl)
+
+	_, err = ParseLevel("bogus")
+	assert.Error(t, err)
+}
+
+func TestLevel_String(t *testing.T) {
+	assert.Equal(t, "DEBUG", LevelDebug.String())
+	assert.Equal(t, "UNKNOWN", Level(99).String())
+}
+
+func TestStdErr_SuppressesMessagesBelowLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := newStdErr(&buf, LevelWarn)
+
+	l.Tracef("should not appear")
+	l.Debugf("should not appear either")
+	assert.Empty(t, buf.String())
+
+	l.Warnf("this one should appear")
+	assert.True(t, strings.Contains(buf.String(), "this one should appear"))
+}
+
+func TestStdErr_SetLevelChangesThreshold(t *testing.T) {
+	var buf bytes.Buffer
+	l := newStdErr(&buf, LevelError)
+
+	l.Warnf("suppressed")
+	assert.Empty(t, buf.String())
+
+	l.SetLevel(LevelWarn)
+	l.Warnf("now visible")
+	assert.True(t, strings.Contains(buf.String(), "now visible"))
+}
+
+func TestNewStdErr_VerboseMapsToDebug(t *testing.T) {
+	assert.Equal(t, LevelDebug, NewStdErr(true).(*stdErr).level)
+	assert.Equal(t, LevelInfo, NewStdErr(false).(*stdErr).level)
+}
@@ -0,0 +1,79 @@
+package persist
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/newrelic/infra-integrations-sdk/log"
+)
+
+const defaultStoreDir = "/var/db/newrelic-infra/integrations"
+
+// DefaultPath returns the default cache file path for an integration name.
+func DefaultPath(integrationName string) string {
+	return filepath.Join(defaultStoreDir, fmt.Sprintf("%s.json", integrationName))
+}
+
+// FileStore is a Storer that keeps entries in memory during the lifetime of
+// the process and serializes them to a JSON file on Save, so the next run
+// of the integration can pick rate/delta calculations up where this one
+// left off.
+type FileStore struct {
+	*InMemoryStore
+	path   string
+	logger log.Logger
+}
+
+// NewFileStore creates a FileStore backed by path, loading any previously
+// saved entries. A missing file is silently treated as empty; an unreadable
+// or corrupt one is logged and ignored: the integration starts with an empty
+// store rather than failing outright.
+func NewFileStore(path string, logger log.Logger) (*FileStore, error) {
+	if logger == nil {
+		logger = log.NewStdErr(false)
+	}
+
+	fs := &FileStore{
+		InMemoryStore: NewInMemoryStore(),
+		path:          path,
+		logger:        logger,
+	}
+
+	if err := fs.load(); err != nil {
+		logger.Warnf("persist: could not load cache from %s: %s", path, err.Error())
+	}
+
+	return fs, nil
+}
+
+func (fs *FileStore) load() error {
+	raw, err := ioutil.ReadFile(fs.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var data map[string]entry
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return err
+	}
+	fs.data = data
+	return nil
+}
+
+// Save implements Storer, writing the current entries to disk as JSON.
+func (fs *FileStore) Save() error {
+	if err := os.MkdirAll(filepath.Dir(fs.path), 0755); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(fs.data)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fs.path, raw, 0644)
+}
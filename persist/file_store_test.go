@@ -0,0 +1,36 @@
+package persist_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/newrelic/infra-integrations-sdk/persist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore_SaveThenReloadRoundTripsValueAndTimestamp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	s, err := persist.NewFileStore(path, nil)
+	assert.NoError(t, err)
+	ts := s.Set("key1", 42.5)
+	assert.NoError(t, s.Save())
+
+	reloaded, err := persist.NewFileStore(path, nil)
+	assert.NoError(t, err)
+
+	v, reloadedTs, ok := reloaded.Get("key1")
+	assert.True(t, ok)
+	assert.Equal(t, 42.5, v)
+	assert.Equal(t, ts, reloadedTs)
+}
+
+func TestFileStore_GetMissingKeyReportsNotFound(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	s, err := persist.NewFileStore(path, nil)
+	assert.NoError(t, err)
+
+	_, _, ok := s.Get("never-set")
+	assert.False(t, ok)
+}
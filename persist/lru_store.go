@@ -0,0 +1,187 @@
+package persist
+
+import (
+	"container/list"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/newrelic/infra-integrations-sdk/log"
+)
+
+// lruEntry is the payload kept in an LRUStore's linked list nodes, and the
+// shape serialized by Save.
+type lruEntry struct {
+	Key       string  `json:"key"`
+	Value     float64 `json:"value"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// LRUStore is a Storer bounded by a maximum number of entries, evicting the
+// least-recently-used one once the cap is reached. An evicted key behaves
+// exactly like one that was never set: Get reports it as missing.
+type LRUStore struct {
+	mutex      sync.Mutex
+	maxEntries int
+	path       string // empty for a purely in-memory LRU
+	logger     log.Logger
+	ll         *list.List
+	index      map[string]*list.Element
+}
+
+// NewLRUInMemoryStore creates an ephemeral LRU-bounded Storer that holds at
+// most maxEntries keys.
+func NewLRUInMemoryStore(maxEntries int) *LRUStore {
+	return newLRUStore("", maxEntries, nil)
+}
+
+// NewLRUFileStore creates an LRU-bounded Storer backed by path, loading any
+// previously saved entries. A missing file is silently treated as empty; an
+// unreadable or corrupt one is logged rather than failing outright, and the
+// store keeps only the entries decoded before the error (none if the file
+// couldn't be opened or didn't start with a JSON array).
+func NewLRUFileStore(path string, maxEntries int, logger log.Logger) (*LRUStore, error) {
+	if logger == nil {
+		logger = log.NewStdErr(false)
+	}
+
+	s := newLRUStore(path, maxEntries, logger)
+	if err := s.load(); err != nil {
+		logger.Warnf("persist: could not load LRU cache from %s: %s", path, err.Error())
+	}
+
+	return s, nil
+}
+
+func newLRUStore(path string, maxEntries int, logger log.Logger) *LRUStore {
+	return &LRUStore{
+		maxEntries: maxEntries,
+		path:       path,
+		logger:     logger,
+		ll:         list.New(),
+		index:      make(map[string]*list.Element),
+	}
+}
+
+// Get implements Storer. A hit promotes the entry to most-recently-used.
+func (s *LRUStore) Get(key string) (float64, int64, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	el, ok := s.index[key]
+	if !ok {
+		return 0, 0, false
+	}
+	s.ll.MoveToFront(el)
+	e := el.Value.(*lruEntry)
+	return e.Value, e.Timestamp, true
+}
+
+// Set implements Storer, evicting the least-recently-used entry if key is
+// new and the store is already at capacity.
+func (s *LRUStore) Set(key string, value float64) int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ts := now().Unix()
+
+	if el, ok := s.index[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.Value, e.Timestamp = value, ts
+		s.ll.MoveToFront(el)
+		return ts
+	}
+
+	el := s.ll.PushFront(&lruEntry{Key: key, Value: value, Timestamp: ts})
+	s.index[key] = el
+
+	if s.maxEntries > 0 && s.ll.Len() > s.maxEntries {
+		s.evictOldest()
+	}
+
+	return ts
+}
+
+// evictOldest drops the least-recently-used entry. Callers must hold mutex.
+func (s *LRUStore) evictOldest() {
+	oldest := s.ll.Back()
+	if oldest == nil {
+		return
+	}
+	s.ll.Remove(oldest)
+	delete(s.index, oldest.Value.(*lruEntry).Key)
+}
+
+// Save implements Storer. For a purely in-memory LRU it is a no-op; for a
+// file-backed one, entries are serialized most-recently-used first so load
+// can stop reading as soon as it has maxEntries, without paying to decode
+// keys it would evict immediately anyway.
+func (s *LRUStore) Save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries := make([]*lruEntry, 0, s.ll.Len())
+	for el := s.ll.Front(); el != nil; el = el.Next() {
+		entries = append(entries, el.Value.(*lruEntry))
+	}
+
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.path, raw, 0644)
+}
+
+// load streams previously saved, MRU-first entries back into the list,
+// stopping as soon as maxEntries have been read instead of decoding (and
+// immediately discarding) the rest of the file. Callers must not hold
+// mutex (it's only ever called before the store is shared).
+func (s *LRUStore) load() error {
+	if s.path == "" {
+		return nil
+	}
+
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	if _, err := dec.Token(); err != nil { // consumes the opening '['
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	for dec.More() {
+		if s.maxEntries > 0 && s.ll.Len() >= s.maxEntries {
+			break
+		}
+
+		var e lruEntry
+		if err := dec.Decode(&e); err != nil {
+			return err
+		}
+
+		el := s.ll.PushBack(&e)
+		s.index[e.Key] = el
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package persist_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/newrelic/infra-integrations-sdk/persist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	s := persist.NewLRUInMemoryStore(2)
+
+	s.Set("key1", 1)
+	s.Set("key2", 2)
+	s.Set("key3", 3) // key1 is the least recently used: evicted.
+
+	_, _, ok := s.Get("key1")
+	assert.False(t, ok, "evicted key should no longer be found")
+
+	v, _, ok := s.Get("key2")
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, v)
+
+	v, _, ok = s.Get("key3")
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, v)
+}
+
+func TestLRUStore_EvictedKeyBehavesAsFirstObservation(t *testing.T) {
+	s := persist.NewLRUInMemoryStore(1)
+
+	s.Set("key1", 100)
+	s.Set("key2", 200) // evicts key1
+
+	_, _, ok := s.Get("key1")
+	assert.False(t, ok, "1st data in key: an evicted key must look unseen")
+}
+
+func TestLRUStore_GetPromotesToMostRecentlyUsed(t *testing.T) {
+	s := persist.NewLRUInMemoryStore(2)
+
+	s.Set("key1", 1)
+	s.Set("key2", 2)
+	s.Get("key1")    // key1 is now the most recently used.
+	s.Set("key3", 3) // key2 is the least recently used: evicted.
+
+	_, _, ok := s.Get("key2")
+	assert.False(t, ok)
+
+	_, _, ok = s.Get("key1")
+	assert.True(t, ok)
+}
+
+func TestLRUStore_SaveThenLoadKeepsMostRecentlyUsed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lru.json")
+
+	s, err := persist.NewLRUFileStore(path, 3, nil)
+	assert.NoError(t, err)
+	s.Set("key1", 1)
+	s.Set("key2", 2)
+	s.Set("key3", 3) // key3 is the most recently used.
+	assert.NoError(t, s.Save())
+
+	// Reload with a smaller cap than what was saved: only the
+	// most-recently-used entries should survive.
+	reloaded, err := persist.NewLRUFileStore(path, 2, nil)
+	assert.NoError(t, err)
+
+	_, _, ok := reloaded.Get("key1")
+	assert.False(t, ok, "key1 was the least recently used and falls outside the smaller cap")
+
+	v, _, ok := reloaded.Get("key2")
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, v)
+
+	v, _, ok = reloaded.Get("key3")
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, v)
+}
@@ -0,0 +1,41 @@
+package persist
+
+// entry pairs a stored value with the timestamp it was set at. Fields are
+// exported (with json tags) so FileStore can serialize them.
+type entry struct {
+	Value     float64 `json:"value"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// InMemoryStore is a Storer that only lives for the duration of the process:
+// Save is a no-op, so nothing survives a restart of the integration.
+type InMemoryStore struct {
+	data map[string]entry
+}
+
+// NewInMemoryStore creates a new ephemeral, in-memory Storer.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{data: map[string]entry{}}
+}
+
+// Get implements Storer.
+func (s *InMemoryStore) Get(key string) (float64, int64, bool) {
+	e, ok := s.data[key]
+	if !ok {
+		return 0, 0, false
+	}
+	return e.Value, e.Timestamp, true
+}
+
+// Set implements Storer.
+func (s *InMemoryStore) Set(key string, value float64) int64 {
+	ts := now().Unix()
+	s.data[key] = entry{Value: value, Timestamp: ts}
+	return ts
+}
+
+// Save implements Storer. It is a no-op: in-memory stores don't persist
+// across process restarts.
+func (s *InMemoryStore) Save() error {
+	return nil
+}
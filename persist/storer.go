@@ -0,0 +1,30 @@
+// Package persist provides the storage backends used to keep metric values
+// between two executions of an integration, so RATE and DELTA metrics can be
+// calculated.
+package persist
+
+import "time"
+
+// now is the clock used to timestamp stored values. It is a package-level
+// variable so tests can make it deterministic via SetNow.
+var now = time.Now
+
+// SetNow overrides the clock used to timestamp stored values. It exists so
+// tests can produce deterministic rate/delta calculations.
+func SetNow(f func() time.Time) {
+	now = f
+}
+
+// Storer is the common interface for storing and retrieving metric values
+// between two executions of an integration.
+type Storer interface {
+	// Get returns the value stored under key, the Unix timestamp at which it
+	// was stored, and whether key was found.
+	Get(key string) (float64, int64, bool)
+	// Set stores value under key at the current time and returns the Unix
+	// timestamp it was stored at.
+	Set(key string, value float64) int64
+	// Save persists the current state of the store so it can be reloaded on
+	// the next execution of the integration.
+	Save() error
+}
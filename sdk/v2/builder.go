@@ -9,6 +9,7 @@ import (
 
 	"github.com/newrelic/infra-integrations-sdk/args"
 	"github.com/newrelic/infra-integrations-sdk/cache"
+	"github.com/newrelic/infra-integrations-sdk/log"
 	"github.com/pkg/errors"
 )
 
@@ -33,12 +34,26 @@ type IntegrationBuilder interface {
 	Cache(cache.Cache) IntegrationBuilder
 	// NoCache disables the cache for this integration.
 	NoCache() IntegrationBuilder
+	// Logger replaces the default logger (stderr, at the level requested via
+	// -log_level/LOG_LEVEL or -verbose).
+	Logger(log.Logger) IntegrationBuilder
+	// ConfigFile loads integration configuration from the YAML or JSON file
+	// at path into the struct passed to ParsedArguments, before CLI flags
+	// and environment variables are applied: file < env < CLI.
+	ConfigFile(path string) IntegrationBuilder
+	// StrictConfigFile opts into strict parsing of the file set via
+	// ConfigFile: a field in the file that doesn't match any field of the
+	// arguments struct becomes a Build() error instead of being ignored.
+	StrictConfigFile() IntegrationBuilder
 }
 
 type integrationBuilderImpl struct {
-	integration *Integration
-	hasCache    bool
-	arguments   interface{}
+	integration      *Integration
+	hasCache         bool
+	arguments        interface{}
+	logger           log.Logger
+	configFilePath   string
+	configFileStrict bool
 }
 
 type disabledLocker struct{}
@@ -87,6 +102,21 @@ func (b *integrationBuilderImpl) NoCache() IntegrationBuilder {
 	return b
 }
 
+func (b *integrationBuilderImpl) Logger(l log.Logger) IntegrationBuilder {
+	b.logger = l
+	return b
+}
+
+func (b *integrationBuilderImpl) ConfigFile(path string) IntegrationBuilder {
+	b.configFilePath = path
+	return b
+}
+
+func (b *integrationBuilderImpl) StrictConfigFile() IntegrationBuilder {
+	b.configFileStrict = true
+	return b
+}
+
 func (b *integrationBuilderImpl) Build() (*Integration, error) {
 	// Checking errors
 	if b.integration.writer == nil {
@@ -103,6 +133,15 @@ func (b *integrationBuilderImpl) Build() (*Integration, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	configFilePath := b.configFilePath
+	if configFilePath == "" {
+		configFilePath = args.ResolveConfigPath()
+	}
+	if err = args.LoadConfigFile(configFilePath, b.arguments, b.configFileStrict); err != nil {
+		return nil, fmt.Errorf("can't load config file: %s", err.Error())
+	}
+
 	err = args.SetupArgs(b.arguments)
 	if err != nil {
 		return nil, err
@@ -111,8 +150,15 @@ func (b *integrationBuilderImpl) Build() (*Integration, error) {
 
 	cache.SetupLogging(defaultArgs.Verbose)
 
+	if b.logger == nil {
+		level, err := args.ResolveLogLevel()
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level: %s", err.Error())
+		}
+		b.logger = log.NewStdErrWithLevel(level)
+	}
+
 	if b.integration.Cache == nil && b.hasCache {
-		// TODO: set Log(log) function to this builder
 		b.integration.Cache, err = cache.NewCache(cache.DefaultPath(b.integration.Name), cache.GlobalLog)
 		if err != nil {
 			return nil, fmt.Errorf("can't create cache: %s", err.Error())